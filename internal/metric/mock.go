@@ -16,6 +16,10 @@ package metric
 
 import (
 	"context"
+	"hash/fnv"
+	"io"
+	"math"
+	"sort"
 	"sync"
 
 	"go.opentelemetry.io/otel/api/core"
@@ -33,6 +37,18 @@ type (
 		Kind       Kind
 		NumberKind core.NumberKind
 		Opts       apimetric.Options
+
+		// CardinalityLimit is the maximum number of distinct LabelSet
+		// fingerprints this Instrument will track before additional label
+		// combinations are collapsed into the Meter's overflow LabelSet. Zero
+		// means "use the Meter's default".
+		CardinalityLimit int
+
+		// HistogramMaxSize and HistogramMaxScale configure the exponential
+		// histogram aggregation used when Kind is KindExponentialHistogram.
+		// Zero means "use the default".
+		HistogramMaxSize  int
+		HistogramMaxScale int32
 	}
 
 	LabelSet struct {
@@ -53,11 +69,33 @@ type (
 	}
 
 	Meter struct {
+		lock sync.RWMutex
+
 		MeasurementBatches []Batch
 		// Observers contains also unregistered
 		// observers. Check the Dead field of the Observer to
 		// figure out its status.
 		Observers []*Observer
+
+		// cardinalityLimit is the default CardinalityLimit applied to
+		// instruments that don't set their own.
+		cardinalityLimit int
+		// seen tracks, per Instrument, the LabelSet fingerprints already
+		// recorded so newly-seen label combinations can be counted against
+		// CardinalityLimit.
+		seen map[*Instrument]map[uint64]struct{}
+		// overflow is the synthetic LabelSet that measurements are
+		// collapsed into once an Instrument's CardinalityLimit is exceeded.
+		overflow *LabelSet
+
+		// BatchObservers contains also unregistered batch observers. Check
+		// the Dead field of the BatchObserver to figure out its status.
+		BatchObservers []*BatchObserver
+
+		// histograms holds the exponential-histogram aggregation state for
+		// each KindExponentialHistogram Instrument, keyed by the fingerprint
+		// of the recording LabelSet's Labels.
+		histograms map[*Instrument]map[uint64]*expHistogramState
 	}
 
 	Kind int8
@@ -66,6 +104,54 @@ type (
 		// Number needs to be aligned for 64-bit atomic operations.
 		Number     core.Number
 		Instrument *Instrument
+		// Histogram is a snapshot of the exponential-histogram aggregation
+		// state after this Measurement was recorded, or nil for
+		// instruments other than KindExponentialHistogram.
+		Histogram *ExponentialHistogramData
+	}
+
+	// ExponentialHistogramBuckets holds one side (positive or negative) of
+	// an exponential histogram's bucket counts.
+	ExponentialHistogramBuckets struct {
+		// Offset is the bucket index of Counts[0].
+		Offset int32
+		Counts []uint64
+	}
+
+	// ExponentialHistogramData is a snapshot of an exponential histogram
+	// aggregation, as returned by Meter.CollectHistogram.
+	ExponentialHistogramData struct {
+		Scale     int32
+		ZeroCount uint64
+		Positive  ExponentialHistogramBuckets
+		Negative  ExponentialHistogramBuckets
+		Sum       core.Number
+		Count     uint64
+		Min       core.Number
+		Max       core.Number
+	}
+
+	// expHistogramBuckets is the mutable, unexported counterpart of
+	// ExponentialHistogramBuckets used while recording.
+	expHistogramBuckets struct {
+		offset int32
+		counts []uint64
+	}
+
+	// expHistogramState is the per-(Instrument, LabelSet) aggregation state
+	// for a KindExponentialHistogram Instrument.
+	expHistogramState struct {
+		scale   int32
+		maxSize int
+
+		zeroCount  uint64
+		positive   expHistogramBuckets
+		negative   expHistogramBuckets
+		sum        float64
+		count      uint64
+		min        float64
+		max        float64
+		haveMinMax bool
 	}
 
 	observerResult struct {
@@ -88,6 +174,38 @@ type (
 		Dead       bool
 		callback   observerCallback
 	}
+
+	// BatchObserver is both the handle returned by RegisterBatchObserver and
+	// the record kept in Meter.BatchObservers to drive RunObservers.
+	BatchObserver struct {
+		Meter    *Meter
+		Name     string
+		Dead     bool
+		callback batchObserverCallback
+	}
+
+	batchObserverCallback func(BatchObserverResult)
+
+	// BatchObserverResult is passed to a callback registered with
+	// RegisterBatchObserver so it can report measurements for multiple
+	// instruments sharing a single LabelSet.
+	BatchObserverResult struct {
+		meter *Meter
+		ctx   context.Context
+	}
+
+	// Int64Observer is an Int64 instrument handle bound to a BatchObserver.
+	// Use Observation to build a measurement for BatchObserverResult.Observe.
+	Int64Observer struct {
+		instrument *Instrument
+	}
+
+	// Float64Observer is a Float64 instrument handle bound to a
+	// BatchObserver. Use Observation to build a measurement for
+	// BatchObserverResult.Observe.
+	Float64Observer struct {
+		instrument *Instrument
+	}
 )
 
 var (
@@ -106,9 +224,104 @@ const (
 	KindGauge
 	KindMeasure
 	KindObserver
+	KindExponentialHistogram
 )
 
+// Defaults for an exponential histogram's resolution and bucket budget,
+// matching NewInt64ExponentialHistogram/NewFloat64ExponentialHistogram when
+// WithMaxSize/WithMaxScale are not passed.
+const (
+	defaultHistogramMaxSize  = 160
+	defaultHistogramMaxScale = 20
+)
+
+// overflowLabelKey is set, with a true value, on the synthetic LabelSet that
+// measurements are collapsed into once an Instrument's CardinalityLimit is
+// exceeded.
+const overflowLabelKey = core.Key("otel.metric.overflow")
+
+// cardinalityLimitOption is a MeterOption as well as a CounterOptionApplier,
+// GaugeOptionApplier, MeasureOptionApplier and ObserverOptionApplier, so it
+// can be passed either to NewMeter to set the Meter-wide default or to any
+// instrument constructor to override it for that Instrument alone.
+type cardinalityLimitOption int
+
+func (o cardinalityLimitOption) ApplyCounterOption(*apimetric.Options)  {}
+func (o cardinalityLimitOption) ApplyGaugeOption(*apimetric.Options)    {}
+func (o cardinalityLimitOption) ApplyMeasureOption(*apimetric.Options)  {}
+func (o cardinalityLimitOption) ApplyObserverOption(*apimetric.Options) {}
+
+func (o cardinalityLimitOption) applyMeterOption(m *Meter) {
+	m.cardinalityLimit = int(o)
+}
+
+// MeterOption applies a configuration setting to a Meter.
+type MeterOption interface {
+	applyMeterOption(*Meter)
+}
+
+// WithCardinalityLimit caps the number of distinct LabelSet fingerprints an
+// instrument will track before collapsing further label combinations into a
+// synthetic overflow LabelSet carrying a single otel.metric.overflow=true
+// label. Pass it to NewMeter for a Meter-wide default, or to an individual
+// instrument constructor to override that default.
+func WithCardinalityLimit(n int) cardinalityLimitOption {
+	return cardinalityLimitOption(n)
+}
+
+// histogramOption is a MeasureOptionApplier that also configures the
+// exponential-histogram aggregation created by
+// NewInt64ExponentialHistogram/NewFloat64ExponentialHistogram.
+type histogramOption interface {
+	apimetric.MeasureOptionApplier
+	applyHistogramOption(*Instrument)
+}
+
+type maxSizeOption int
+
+func (o maxSizeOption) ApplyMeasureOption(*apimetric.Options) {}
+func (o maxSizeOption) applyHistogramOption(i *Instrument)    { i.HistogramMaxSize = int(o) }
+
+// WithMaxSize sets the maximum number of buckets an exponential histogram
+// maintains on each side of zero before downscaling. Default 160.
+func WithMaxSize(n int) histogramOption {
+	return maxSizeOption(n)
+}
+
+type maxScaleOption int32
+
+func (o maxScaleOption) ApplyMeasureOption(*apimetric.Options) {}
+func (o maxScaleOption) applyHistogramOption(i *Instrument)    { i.HistogramMaxScale = int32(o) }
+
+// WithMaxScale sets the starting scale (bucket resolution) of an
+// exponential histogram before any downscaling. Default 20.
+func WithMaxScale(n int32) histogramOption {
+	return maxScaleOption(n)
+}
+
+// labelSetFingerprint returns a stable hash of labels, keyed on the sorted
+// key/value pairs so that equal LabelSets always collide.
+func labelSetFingerprint(labels map[core.Key]core.Value) uint64 {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, string(k))
+	}
+	sort.Strings(keys)
+
+	h := fnv.New64a()
+	for _, k := range keys {
+		val := labels[core.Key(k)]
+		_, _ = io.WriteString(h, k)
+		_, _ = io.WriteString(h, "=")
+		_, _ = io.WriteString(h, val.Emit())
+		_, _ = io.WriteString(h, ";")
+	}
+	return h.Sum64()
+}
+
 func (o *Observer) Unregister() {
+	o.Meter.lock.Lock()
+	defer o.Meter.lock.Unlock()
 	o.Dead = true
 }
 
@@ -177,8 +390,12 @@ func (p *MeterProvider) Meter(name string) apimetric.Meter {
 	return m
 }
 
-func NewMeter() *Meter {
-	return &Meter{}
+func NewMeter(mos ...MeterOption) *Meter {
+	m := &Meter{}
+	for _, mo := range mos {
+		mo.applyMeterOption(m)
+	}
+	return m
 }
 
 func (m *Meter) Labels(labels ...core.KeyValue) apimetric.LabelSet {
@@ -205,12 +422,18 @@ func (m *Meter) NewFloat64Counter(name string, cos ...apimetric.CounterOptionApp
 func (m *Meter) newCounterInstrument(name string, numberKind core.NumberKind, cos ...apimetric.CounterOptionApplier) *Instrument {
 	opts := apimetric.Options{}
 	apimetric.ApplyCounterOptions(&opts, cos...)
-	return &Instrument{
+	inst := &Instrument{
 		Name:       name,
 		Kind:       KindCounter,
 		NumberKind: numberKind,
 		Opts:       opts,
 	}
+	for _, co := range cos {
+		if cl, ok := co.(cardinalityLimitOption); ok {
+			inst.CardinalityLimit = int(cl)
+		}
+	}
+	return inst
 }
 
 func (m *Meter) NewInt64Gauge(name string, gos ...apimetric.GaugeOptionApplier) apimetric.Int64Gauge {
@@ -226,12 +449,18 @@ func (m *Meter) NewFloat64Gauge(name string, gos ...apimetric.GaugeOptionApplier
 func (m *Meter) newGaugeInstrument(name string, numberKind core.NumberKind, gos ...apimetric.GaugeOptionApplier) *Instrument {
 	opts := apimetric.Options{}
 	apimetric.ApplyGaugeOptions(&opts, gos...)
-	return &Instrument{
+	inst := &Instrument{
 		Name:       name,
 		Kind:       KindGauge,
 		NumberKind: numberKind,
 		Opts:       opts,
 	}
+	for _, gopt := range gos {
+		if cl, ok := gopt.(cardinalityLimitOption); ok {
+			inst.CardinalityLimit = int(cl)
+		}
+	}
+	return inst
 }
 
 func (m *Meter) NewInt64Measure(name string, mos ...apimetric.MeasureOptionApplier) apimetric.Int64Measure {
@@ -247,12 +476,249 @@ func (m *Meter) NewFloat64Measure(name string, mos ...apimetric.MeasureOptionApp
 func (m *Meter) newMeasureInstrument(name string, numberKind core.NumberKind, mos ...apimetric.MeasureOptionApplier) *Instrument {
 	opts := apimetric.Options{}
 	apimetric.ApplyMeasureOptions(&opts, mos...)
-	return &Instrument{
+	inst := &Instrument{
 		Name:       name,
 		Kind:       KindMeasure,
 		NumberKind: numberKind,
 		Opts:       opts,
 	}
+	for _, mopt := range mos {
+		if cl, ok := mopt.(cardinalityLimitOption); ok {
+			inst.CardinalityLimit = int(cl)
+		}
+	}
+	return inst
+}
+
+// NewInt64ExponentialHistogram creates an Int64 instrument whose recorded
+// values feed a base-2 exponential-histogram aggregation, inspectable via
+// Meter.CollectHistogram or the Histogram field of recorded Measurements.
+func (m *Meter) NewInt64ExponentialHistogram(name string, mos ...apimetric.MeasureOptionApplier) apimetric.Int64Measure {
+	instrument := m.newExponentialHistogramInstrument(name, core.Int64NumberKind, mos...)
+	return apimetric.WrapInt64MeasureInstrument(instrument)
+}
+
+// NewFloat64ExponentialHistogram creates a Float64 instrument whose recorded
+// values feed a base-2 exponential-histogram aggregation, inspectable via
+// Meter.CollectHistogram or the Histogram field of recorded Measurements.
+func (m *Meter) NewFloat64ExponentialHistogram(name string, mos ...apimetric.MeasureOptionApplier) apimetric.Float64Measure {
+	instrument := m.newExponentialHistogramInstrument(name, core.Float64NumberKind, mos...)
+	return apimetric.WrapFloat64MeasureInstrument(instrument)
+}
+
+func (m *Meter) newExponentialHistogramInstrument(name string, numberKind core.NumberKind, mos ...apimetric.MeasureOptionApplier) *Instrument {
+	opts := apimetric.Options{}
+	apimetric.ApplyMeasureOptions(&opts, mos...)
+	inst := &Instrument{
+		Name:              name,
+		Kind:              KindExponentialHistogram,
+		NumberKind:        numberKind,
+		Opts:              opts,
+		HistogramMaxSize:  defaultHistogramMaxSize,
+		HistogramMaxScale: defaultHistogramMaxScale,
+	}
+	for _, mopt := range mos {
+		if cl, ok := mopt.(cardinalityLimitOption); ok {
+			inst.CardinalityLimit = int(cl)
+		}
+		if ho, ok := mopt.(histogramOption); ok {
+			ho.applyHistogramOption(inst)
+		}
+	}
+	return inst
+}
+
+// CollectHistogram snapshots the current exponential-histogram aggregation
+// state for instrument under labels, or nil if nothing has been recorded
+// for that Instrument/LabelSet combination yet.
+func (m *Meter) CollectHistogram(instrument *Instrument, labels apimetric.LabelSet) *ExponentialHistogramData {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	ourLabelSet := labels.(*LabelSet)
+	byLabels := m.histograms[instrument]
+	if byLabels == nil {
+		return nil
+	}
+	state := byLabels[labelSetFingerprint(ourLabelSet.Labels)]
+	if state == nil {
+		return nil
+	}
+	return state.snapshot(instrument.NumberKind)
+}
+
+// recordExponentialHistogram folds number into instrument's exponential
+// histogram for labelSet, creating the aggregation on first use, and
+// returns a snapshot of the updated state. m.lock must be held by the
+// caller.
+func (m *Meter) recordExponentialHistogram(instrument *Instrument, labelSet *LabelSet, number core.Number) *ExponentialHistogramData {
+	if m.histograms == nil {
+		m.histograms = map[*Instrument]map[uint64]*expHistogramState{}
+	}
+	byLabels := m.histograms[instrument]
+	if byLabels == nil {
+		byLabels = map[uint64]*expHistogramState{}
+		m.histograms[instrument] = byLabels
+	}
+	fp := labelSetFingerprint(labelSet.Labels)
+	state := byLabels[fp]
+	if state == nil {
+		state = newExpHistogramState(instrument)
+		byLabels[fp] = state
+	}
+	state.record(number.CoerceToFloat64(instrument.NumberKind))
+	return state.snapshot(instrument.NumberKind)
+}
+
+func newExpHistogramState(instrument *Instrument) *expHistogramState {
+	maxSize := instrument.HistogramMaxSize
+	if maxSize <= 0 {
+		maxSize = defaultHistogramMaxSize
+	}
+	maxScale := instrument.HistogramMaxScale
+	if maxScale == 0 {
+		maxScale = defaultHistogramMaxScale
+	}
+	return &expHistogramState{
+		scale:   maxScale,
+		maxSize: maxSize,
+	}
+}
+
+func (s *expHistogramState) record(v float64) {
+	s.count++
+	s.sum += v
+	if !s.haveMinMax || v < s.min {
+		s.min = v
+	}
+	if !s.haveMinMax || v > s.max {
+		s.max = v
+	}
+	s.haveMinMax = true
+
+	switch {
+	case v == 0:
+		s.zeroCount++
+	case v > 0:
+		s.insert(&s.positive, v)
+	default:
+		s.insert(&s.negative, -v)
+	}
+}
+
+// insert increments the bucket for abs in bucket, downscaling (halving the
+// resolution of both the positive and negative bucket arrays) as many
+// times as needed for abs's bucket to fit within maxSize.
+func (s *expHistogramState) insert(bucket *expHistogramBuckets, abs float64) {
+	for {
+		index := expHistogramBucketIndex(abs, s.scale)
+		if bucket.tryIncrement(index, s.maxSize) {
+			return
+		}
+		s.downscale()
+	}
+}
+
+func (s *expHistogramState) downscale() {
+	s.positive.downscale()
+	s.negative.downscale()
+	s.scale--
+}
+
+func (s *expHistogramState) snapshot(kind core.NumberKind) *ExponentialHistogramData {
+	var sum, min, max core.Number
+	if kind == core.Int64NumberKind {
+		sum = core.NewInt64Number(int64(s.sum))
+		min = core.NewInt64Number(int64(s.min))
+		max = core.NewInt64Number(int64(s.max))
+	} else {
+		sum = core.NewFloat64Number(s.sum)
+		min = core.NewFloat64Number(s.min)
+		max = core.NewFloat64Number(s.max)
+	}
+	return &ExponentialHistogramData{
+		Scale:     s.scale,
+		ZeroCount: s.zeroCount,
+		Positive:  s.positive.snapshot(),
+		Negative:  s.negative.snapshot(),
+		Sum:       sum,
+		Count:     s.count,
+		Min:       min,
+		Max:       max,
+	}
+}
+
+// expHistogramBucketIndex returns the index of the bucket that value (which
+// must be > 0) falls into at the given scale: floor(log2(value) * 2^scale).
+func expHistogramBucketIndex(value float64, scale int32) int32 {
+	return int32(math.Floor(math.Log2(value) * math.Exp2(float64(scale))))
+}
+
+// tryIncrement increments the count for index, growing counts/offset as
+// needed, and returns true. If including index would make b span more than
+// maxSize buckets, it leaves b unchanged and returns false.
+func (b *expHistogramBuckets) tryIncrement(index int32, maxSize int) bool {
+	if len(b.counts) == 0 {
+		low, high := index, index
+		if int(high-low)+1 > maxSize {
+			return false
+		}
+		b.offset = index
+		b.counts = []uint64{1}
+		return true
+	}
+
+	low, high := b.offset, b.offset+int32(len(b.counts))-1
+	if index < low {
+		low = index
+	}
+	if index > high {
+		high = index
+	}
+	if int(high-low)+1 > maxSize {
+		return false
+	}
+
+	if index < b.offset {
+		grow := b.offset - index
+		grown := make([]uint64, int32(len(b.counts))+grow)
+		copy(grown[grow:], b.counts)
+		b.counts = grown
+		b.offset = index
+	} else if idx := int(index - b.offset); idx >= len(b.counts) {
+		grown := make([]uint64, idx+1)
+		copy(grown, b.counts)
+		b.counts = grown
+	}
+	b.counts[index-b.offset]++
+	return true
+}
+
+// downscale halves the resolution of b, merging adjacent bucket pairs and
+// shifting the offset to match. Go's arithmetic right shift rounds toward
+// negative infinity, matching the floor-division the algorithm requires.
+func (b *expHistogramBuckets) downscale() {
+	if len(b.counts) == 0 {
+		return
+	}
+	newOffset := b.offset >> 1
+	lastOld := b.offset + int32(len(b.counts)) - 1
+	newCounts := make([]uint64, (lastOld>>1)-newOffset+1)
+	for i, c := range b.counts {
+		if c == 0 {
+			continue
+		}
+		oldIndex := b.offset + int32(i)
+		newCounts[(oldIndex>>1)-newOffset] += c
+	}
+	b.offset = newOffset
+	b.counts = newCounts
+}
+
+func (b expHistogramBuckets) snapshot() ExponentialHistogramBuckets {
+	counts := make([]uint64, len(b.counts))
+	copy(counts, b.counts)
+	return ExponentialHistogramBuckets{Offset: b.offset, Counts: counts}
 }
 
 func (m *Meter) RegisterInt64Observer(name string, callback apimetric.Int64ObserverCallback, oos ...apimetric.ObserverOptionApplier) apimetric.Int64Observer {
@@ -289,47 +755,192 @@ func wrapFloat64ObserverCallback(callback apimetric.Float64ObserverCallback) obs
 	}
 }
 
-func (m *Meter) newObserver(name string, callback observerCallback, numberKind core.NumberKind, oos ...apimetric.ObserverOptionApplier) *Observer {
+func (m *Meter) newObserverInstrument(name string, numberKind core.NumberKind, oos ...apimetric.ObserverOptionApplier) *Instrument {
 	opts := apimetric.Options{}
 	apimetric.ApplyObserverOptions(&opts, oos...)
+	inst := &Instrument{
+		Name:       name,
+		Kind:       KindObserver,
+		NumberKind: numberKind,
+		Opts:       opts,
+	}
+	for _, oopt := range oos {
+		if cl, ok := oopt.(cardinalityLimitOption); ok {
+			inst.CardinalityLimit = int(cl)
+		}
+	}
+	return inst
+}
+
+func (m *Meter) newObserver(name string, callback observerCallback, numberKind core.NumberKind, oos ...apimetric.ObserverOptionApplier) *Observer {
 	obs := &Observer{
-		Instrument: &Instrument{
-			Name:       name,
-			Kind:       KindObserver,
-			NumberKind: numberKind,
-			Opts:       opts,
-		},
-		Meter:    m,
-		Dead:     false,
-		callback: callback,
+		Instrument: m.newObserverInstrument(name, numberKind, oos...),
+		Meter:      m,
+		Dead:       false,
+		callback:   callback,
 	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
 	m.Observers = append(m.Observers, obs)
 	return obs
 }
 
-func (m *Meter) RecordBatch(ctx context.Context, labels apimetric.LabelSet, measurements ...apimetric.Measurement) {
+// RegisterBatchObserver registers a callback that is invoked once per
+// RunObservers call and reports measurements for one or more instruments,
+// created via the returned BatchObserver's Int64Observer/Float64Observer
+// methods, sharing a single LabelSet.
+func (m *Meter) RegisterBatchObserver(name string, callback func(BatchObserverResult)) *BatchObserver {
+	bo := &BatchObserver{
+		Meter:    m,
+		Name:     name,
+		callback: callback,
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.BatchObservers = append(m.BatchObservers, bo)
+	return bo
+}
+
+// Unregister marks the BatchObserver dead; RunObservers will skip it.
+func (bo *BatchObserver) Unregister() {
+	bo.Meter.lock.Lock()
+	defer bo.Meter.lock.Unlock()
+	bo.Dead = true
+}
+
+// Int64Observer creates an Int64 instrument handle bound to bo, for use
+// with BatchObserverResult.Observe inside bo's callback.
+func (bo *BatchObserver) Int64Observer(name string, oos ...apimetric.ObserverOptionApplier) Int64Observer {
+	return Int64Observer{instrument: bo.Meter.newObserverInstrument(name, core.Int64NumberKind, oos...)}
+}
+
+// Float64Observer creates a Float64 instrument handle bound to bo, for use
+// with BatchObserverResult.Observe inside bo's callback.
+func (bo *BatchObserver) Float64Observer(name string, oos ...apimetric.ObserverOptionApplier) Float64Observer {
+	return Float64Observer{instrument: bo.Meter.newObserverInstrument(name, core.Float64NumberKind, oos...)}
+}
+
+// Observation builds a measurement of v for BatchObserverResult.Observe.
+func (o Int64Observer) Observation(v int64) apimetric.Measurement {
+	return apimetric.WrapInt64MeasureInstrument(o.instrument).Measurement(v)
+}
+
+// Observation builds a measurement of v for BatchObserverResult.Observe.
+func (o Float64Observer) Observation(v float64) apimetric.Measurement {
+	return apimetric.WrapFloat64MeasureInstrument(o.instrument).Measurement(v)
+}
+
+// Observe records measurements, sharing labels, for the instruments created
+// from this result's BatchObserver as a single Batch.
+func (r BatchObserverResult) Observe(labels apimetric.LabelSet, measurements ...apimetric.Measurement) {
 	ourLabelSet := labels.(*LabelSet)
+	r.meter.recordMockBatch(r.ctx, ourLabelSet, toMockMeasurements(measurements)...)
+}
+
+func toMockMeasurements(measurements []apimetric.Measurement) []Measurement {
 	mm := make([]Measurement, len(measurements))
 	for i := 0; i < len(measurements); i++ {
-		m := measurements[i]
+		meas := measurements[i]
 		mm[i] = Measurement{
-			Instrument: m.InstrumentImpl().(*Instrument),
-			Number:     m.Number(),
+			Instrument: meas.InstrumentImpl().(*Instrument),
+			Number:     meas.Number(),
 		}
 	}
-	m.recordMockBatch(ctx, ourLabelSet, mm...)
+	return mm
+}
+
+func (m *Meter) RecordBatch(ctx context.Context, labels apimetric.LabelSet, measurements ...apimetric.Measurement) {
+	ourLabelSet := labels.(*LabelSet)
+	m.recordMockBatch(ctx, ourLabelSet, toMockMeasurements(measurements)...)
 }
 
 func (m *Meter) recordMockBatch(ctx context.Context, labelSet *LabelSet, measurements ...Measurement) {
-	m.MeasurementBatches = append(m.MeasurementBatches, Batch{
-		Ctx:          ctx,
-		LabelSet:     labelSet,
-		Measurements: measurements,
-	})
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// Group measurements by the LabelSet they end up recorded under: either
+	// the original labelSet, or the overflow LabelSet for instruments that
+	// have exceeded their CardinalityLimit. Most calls have a single group
+	// equal to labelSet, matching prior behavior exactly.
+	var order []*LabelSet
+	groups := make(map[*LabelSet][]Measurement, 1)
+	for _, meas := range measurements {
+		effective := m.effectiveLabelSet(meas.Instrument, labelSet)
+		if meas.Instrument.Kind == KindExponentialHistogram {
+			meas.Histogram = m.recordExponentialHistogram(meas.Instrument, effective, meas.Number)
+		}
+		if _, ok := groups[effective]; !ok {
+			order = append(order, effective)
+		}
+		groups[effective] = append(groups[effective], meas)
+	}
+
+	for _, ls := range order {
+		m.MeasurementBatches = append(m.MeasurementBatches, Batch{
+			Ctx:          ctx,
+			LabelSet:     ls,
+			Measurements: groups[ls],
+		})
+	}
+}
+
+// effectiveLabelSet returns labelSet unchanged if instrument has not yet
+// exceeded its CardinalityLimit for the fingerprint of labelSet's Labels,
+// recording it as seen. Once the limit is exceeded, it returns the Meter's
+// overflow LabelSet instead. m.lock must be held by the caller.
+func (m *Meter) effectiveLabelSet(instrument *Instrument, labelSet *LabelSet) *LabelSet {
+	limit := instrument.CardinalityLimit
+	if limit == 0 {
+		limit = m.cardinalityLimit
+	}
+	if limit <= 0 {
+		return labelSet
+	}
+
+	if m.seen == nil {
+		m.seen = map[*Instrument]map[uint64]struct{}{}
+	}
+	seen := m.seen[instrument]
+	if seen == nil {
+		seen = map[uint64]struct{}{}
+		m.seen[instrument] = seen
+	}
+
+	fp := labelSetFingerprint(labelSet.Labels)
+	if _, ok := seen[fp]; ok {
+		return labelSet
+	}
+	if len(seen) >= limit {
+		return m.overflowLabelSet()
+	}
+	seen[fp] = struct{}{}
+	return labelSet
+}
+
+// overflowLabelSet returns the Meter's single synthetic overflow LabelSet,
+// creating it on first use.
+func (m *Meter) overflowLabelSet() *LabelSet {
+	if m.overflow == nil {
+		m.overflow = &LabelSet{
+			TheMeter: m,
+			Labels:   map[core.Key]core.Value{overflowLabelKey: core.Bool(true)},
+		}
+	}
+	return m.overflow
 }
 
 func (m *Meter) RunObservers() {
-	for _, observer := range m.Observers {
+	// Snapshot the registered observers under lock, then invoke callbacks
+	// without holding it: callbacks record measurements, which re-enters
+	// the Meter and would otherwise self-deadlock.
+	m.lock.RLock()
+	observers := make([]*Observer, len(m.Observers))
+	copy(observers, m.Observers)
+	batchObservers := make([]*BatchObserver, len(m.BatchObservers))
+	copy(batchObservers, m.BatchObservers)
+	m.lock.RUnlock()
+
+	for _, observer := range observers {
 		if observer.Dead {
 			continue
 		}
@@ -337,4 +948,46 @@ func (m *Meter) RunObservers() {
 			instrument: observer.Instrument,
 		})
 	}
+	for _, bo := range batchObservers {
+		if bo.Dead {
+			continue
+		}
+		bo.callback(BatchObserverResult{
+			meter: m,
+			ctx:   context.Background(),
+		})
+	}
+}
+
+// Batches returns a defensive copy of the Batches recorded so far.
+func (m *Meter) Batches() []Batch {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	batches := make([]Batch, len(m.MeasurementBatches))
+	copy(batches, m.MeasurementBatches)
+	return batches
+}
+
+// Reset clears the recorded Batches without dropping registered
+// instruments, observers, or histogram aggregation state.
+func (m *Meter) Reset() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.MeasurementBatches = nil
+}
+
+// MeasurementsFor returns the Measurements, across all recorded Batches,
+// whose Instrument name is instrumentName.
+func (m *Meter) MeasurementsFor(instrumentName string) []Measurement {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	var out []Measurement
+	for _, batch := range m.MeasurementBatches {
+		for _, meas := range batch.Measurements {
+			if meas.Instrument.Name == instrumentName {
+				out = append(out, meas)
+			}
+		}
+	}
+	return out
 }
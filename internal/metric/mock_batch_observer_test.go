@@ -0,0 +1,51 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import "testing"
+
+func TestBatchObserverGroupsMeasurementsByLabelSet(t *testing.T) {
+	meter := NewMeter()
+
+	var cpu Int64Observer
+	var mem Float64Observer
+	bo := meter.RegisterBatchObserver("host", func(result BatchObserverResult) {
+		labels := meter.Labels()
+		result.Observe(labels, cpu.Observation(1), mem.Observation(2.5))
+	})
+	cpu = bo.Int64Observer("cpu.load")
+	mem = bo.Float64Observer("mem.used")
+
+	meter.RunObservers()
+
+	batches := meter.Batches()
+	if len(batches) != 1 {
+		t.Fatalf("got %d batches, want 1 (cpu and mem share a LabelSet)", len(batches))
+	}
+	if len(batches[0].Measurements) != 2 {
+		t.Fatalf("got %d measurements, want 2", len(batches[0].Measurements))
+	}
+
+	byName := map[string]Measurement{}
+	for _, m := range batches[0].Measurements {
+		byName[m.Instrument.Name] = m
+	}
+	if m, ok := byName["cpu.load"]; !ok || m.Number.AsInt64() != 1 {
+		t.Errorf("cpu.load measurement = %+v", m)
+	}
+	if m, ok := byName["mem.used"]; !ok || m.Number.AsFloat64() != 2.5 {
+		t.Errorf("mem.used measurement = %+v", m)
+	}
+}
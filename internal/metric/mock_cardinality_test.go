@@ -0,0 +1,58 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/api/core"
+)
+
+func TestMeterCardinalityLimitOverflow(t *testing.T) {
+	meter := NewMeter(WithCardinalityLimit(2))
+	counter := meter.NewInt64Counter("requests", WithCardinalityLimit(2))
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		labels := meter.Labels(core.Key("shard").Int(i))
+		counter.Add(ctx, 1, labels)
+	}
+	// A label combination already seen stays under its own LabelSet, even
+	// after the limit has been exceeded.
+	counter.Add(ctx, 1, meter.Labels(core.Key("shard").Int(0)))
+
+	batches := meter.Batches()
+	if len(batches) != 4 {
+		t.Fatalf("got %d batches, want 4", len(batches))
+	}
+
+	var overflowCount, shard0Count int
+	for _, b := range batches {
+		if _, ok := b.LabelSet.Labels[overflowLabelKey]; ok {
+			overflowCount += len(b.Measurements)
+			continue
+		}
+		if v, ok := b.LabelSet.Labels[core.Key("shard")]; ok && v.AsInt64() == 0 {
+			shard0Count += len(b.Measurements)
+		}
+	}
+	if overflowCount != 1 {
+		t.Errorf("got %d overflow measurements, want 1", overflowCount)
+	}
+	if shard0Count != 2 {
+		t.Errorf("got %d shard=0 measurements, want 2", shard0Count)
+	}
+}
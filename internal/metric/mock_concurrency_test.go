@@ -0,0 +1,64 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	apimetric "go.opentelemetry.io/otel/api/metric"
+)
+
+// TestMeterConcurrentAccess exercises RecordOne, RunObservers, Batches and
+// CollectHistogram from many goroutines at once. It exists to be run under
+// `go test -race`: none of these paths should be able to trip the race
+// detector.
+func TestMeterConcurrentAccess(t *testing.T) {
+	meter := NewMeter()
+	counter := meter.NewInt64Counter("requests")
+	histogram := meter.NewFloat64ExponentialHistogram("latency")
+	labels := meter.Labels()
+	ctx := context.Background()
+
+	meter.RegisterInt64Observer("queue.depth", func(result apimetric.Int64ObserverResult) {
+		result.Observe(1, labels)
+	})
+
+	var wg sync.WaitGroup
+	const goroutines = 8
+	const iterations = 50
+
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				counter.Add(ctx, 1, labels)
+				histogram.Record(ctx, float64(i), labels)
+				meter.RunObservers()
+				_ = meter.Batches()
+				if inst := histogramInstrumentNamed(meter, "latency"); inst != nil {
+					_ = meter.CollectHistogram(inst, labels)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := len(meter.MeasurementsFor("requests")), goroutines*iterations; got != want {
+		t.Errorf("got %d measurements, want %d", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2019, OpenTelemetry Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metric
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExponentialHistogramDownscalesWhenBucketsOverflow(t *testing.T) {
+	meter := NewMeter()
+	histogram := meter.NewFloat64ExponentialHistogram("latency", WithMaxSize(4), WithMaxScale(20))
+	labels := meter.Labels()
+
+	ctx := context.Background()
+	for _, v := range []float64{1, 2, 4, 8, 16} {
+		histogram.Record(ctx, v, labels)
+	}
+
+	data := meter.CollectHistogram(histogramInstrumentNamed(meter, "latency"), labels)
+	if data == nil {
+		t.Fatal("got nil ExponentialHistogramData")
+	}
+	if data.Scale >= 20 {
+		t.Errorf("got scale %d, want less than the starting scale of 20 after downscaling", data.Scale)
+	}
+	if got := len(data.Positive.Counts); got > 4 {
+		t.Errorf("got %d buckets, want at most maxSize (4)", got)
+	}
+	if data.Count != 5 {
+		t.Errorf("got count %d, want 5", data.Count)
+	}
+	if data.Sum.AsFloat64() != 31 {
+		t.Errorf("got sum %v, want 31", data.Sum.AsFloat64())
+	}
+}
+
+// histogramInstrumentNamed looks up the *Instrument the Meter created for
+// name, since CollectHistogram is keyed by *Instrument rather than by name.
+func histogramInstrumentNamed(meter *Meter, name string) *Instrument {
+	meter.lock.RLock()
+	defer meter.lock.RUnlock()
+	for inst := range meter.histograms {
+		if inst.Name == name {
+			return inst
+		}
+	}
+	return nil
+}